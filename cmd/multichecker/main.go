@@ -0,0 +1,15 @@
+// Command multichecker composes the rbac and rbacmarkers analyzers into a
+// single binary, ready to grow alongside other analysis.Analyzers (custom
+// lint rules, go vet's standard passes, ...) without users needing to run
+// several separate vet tools.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/multichecker"
+
+	"github.com/yitzhak12/rbac-analyzer/pkg/rbacanalyzer"
+)
+
+func main() {
+	multichecker.Main(rbacanalyzer.Analyzer, rbacanalyzer.MarkersAnalyzer)
+}
@@ -0,0 +1,15 @@
+// Command rbacanalyzer reports the Kubernetes RBAC permissions a program
+// requires through its use of controller-runtime's client.Client, as
+// diagnostics on the exact call site that needs each verb. It can also be
+// run under `go vet -vettool=` or composed with other analysis.Analyzers.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/yitzhak12/rbac-analyzer/pkg/rbacanalyzer"
+)
+
+func main() {
+	singlechecker.Main(rbacanalyzer.Analyzer)
+}
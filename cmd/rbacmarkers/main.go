@@ -0,0 +1,15 @@
+// Command rbacmarkers cross-checks +kubebuilder:rbac marker comments against
+// the RBAC permissions a program's client.Client calls actually require,
+// flagging markers that are missing (and, with -strict, ones that are
+// unused) so marker drift fails CI instead of silently breaking deployments.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/yitzhak12/rbac-analyzer/pkg/rbacanalyzer"
+)
+
+func main() {
+	singlechecker.Main(rbacanalyzer.MarkersAnalyzer)
+}
@@ -0,0 +1,81 @@
+// Command rbacroles renders the RBAC permissions a repository's
+// controllers require as one ClusterRole (or +kubebuilder:rbac marker block)
+// per Reconciler, plus a merged role aggregating all of them -- ready to feed
+// into config/rbac/role.yaml generation.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/yitzhak12/rbac-analyzer/pkg/rbacanalyzer"
+)
+
+func main() {
+	outputFormat := flag.String("output", "yaml", "Output format: yaml or markers")
+	rolePrefix := flag.String("role-prefix", "rbac-analyzer", "Prefix used to name the generated roles")
+	mode := flag.String("mode", "ast", "Analysis mode: ast (fast, direct call sites only) or ssa (slower; follows helper functions and interface-typed parameters through a call graph)")
+
+	flag.Parse()
+
+	switch *outputFormat {
+	case "yaml", "markers":
+	default:
+		slog.Error("Invalid usage", "error", fmt.Sprintf("unknown -output value %q", *outputFormat))
+		os.Exit(1)
+	}
+
+	switch *mode {
+	case "ast", "ssa":
+	default:
+		slog.Error("Invalid usage", "error", fmt.Sprintf("unknown -mode value %q", *mode))
+		os.Exit(1)
+	}
+
+	if flag.NArg() != 1 {
+		slog.Error("Invalid usage", "error", "Missing repository path")
+		slog.Info("Usage: ./rbacroles -output=<yaml|markers> -mode=<ast|ssa> -role-prefix=<prefix> <path_to_go_repo>")
+		os.Exit(1)
+	}
+
+	repoPath, err := filepath.Abs(flag.Arg(0))
+	if err != nil {
+		slog.Error("Error getting absolute path", "error", err)
+		os.Exit(1)
+	}
+
+	loadMode := packages.NeedFiles | packages.NeedSyntax | packages.NeedTypesInfo | packages.NeedTypes | packages.NeedImports
+	if *mode == "ssa" {
+		// SSA construction needs the full dependency graph, not just direct imports.
+		loadMode |= packages.NeedDeps | packages.NeedName
+	}
+
+	cfg := &packages.Config{
+		Mode: loadMode,
+		Dir:  repoPath,
+	}
+
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		slog.Error("Error loading packages", "error", err)
+		os.Exit(1)
+	}
+
+	var result rbacanalyzer.Result
+	if *mode == "ssa" {
+		result, err = rbacanalyzer.RunSSA(pkgs)
+	} else {
+		result, err = rbacanalyzer.RunOnPackages(pkgs)
+	}
+	if err != nil {
+		slog.Error("Error analyzing packages", "error", err)
+		os.Exit(1)
+	}
+
+	fmt.Print(rbacanalyzer.RenderPerController(*rolePrefix, result, *outputFormat == "markers"))
+}
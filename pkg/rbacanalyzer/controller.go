@@ -0,0 +1,83 @@
+package rbacanalyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const reconcilePackage = "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+// enclosingController walks stack (the ancestors of the node currently being
+// visited, innermost last) looking for the nearest enclosing method whose
+// receiver is a Reconciler: either the method is literally named Reconcile,
+// or its receiver type implements reconcile.Reconciler from
+// sigs.k8s.io/controller-runtime/pkg/reconcile. It returns the receiver
+// type's name and true, or "" and false if call isn't inside one.
+func enclosingController(pass *analysis.Pass, stack []ast.Node) (Controller, bool) {
+	iface := reconcilerInterface(findImportedPackage(pass.Pkg, reconcilePackage, make(map[*types.Package]bool)))
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		fn, ok := stack[i].(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+			continue
+		}
+
+		named, ok := namedType(pass.TypesInfo.TypeOf(fn.Recv.List[0].Type))
+		if !ok {
+			continue
+		}
+
+		if controller, ok := controllerName(named, fn.Name.Name, iface); ok {
+			return controller, true
+		}
+	}
+	return "", false
+}
+
+// controllerName reports the Controller identified by named being used as a
+// method receiver, if it's a Reconciler: either methodName is literally
+// "Reconcile", or named (or *named) implements reconcile.Reconciler. iface
+// may be nil, e.g. if the program under analysis doesn't import the
+// reconcile package at all, in which case only the name heuristic applies.
+func controllerName(named *types.Named, methodName string, iface *types.Interface) (Controller, bool) {
+	if methodName == "Reconcile" ||
+		(iface != nil && (types.Implements(named, iface) || types.Implements(types.NewPointer(named), iface))) {
+		return Controller(named.Obj().Name()), true
+	}
+	return "", false
+}
+
+// reconcilerInterface extracts the reconcile.Reconciler interface type from
+// pkg, or nil if pkg is nil (the program doesn't depend on it) or doesn't
+// declare it.
+func reconcilerInterface(pkg *types.Package) *types.Interface {
+	if pkg == nil {
+		return nil
+	}
+	obj := pkg.Scope().Lookup("Reconciler")
+	if obj == nil {
+		return nil
+	}
+	iface, _ := obj.Type().Underlying().(*types.Interface)
+	return iface
+}
+
+// findImportedPackage searches pkg's transitive imports for path.
+func findImportedPackage(pkg *types.Package, path string, seen map[*types.Package]bool) *types.Package {
+	if pkg == nil || seen[pkg] {
+		return nil
+	}
+	seen[pkg] = true
+
+	if pkg.Path() == path {
+		return pkg
+	}
+	for _, imp := range pkg.Imports() {
+		if found := findImportedPackage(imp, path, seen); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package rbacanalyzer
+
+import (
+	"go/types"
+	"regexp"
+	"strings"
+)
+
+// GVR identifies a Kubernetes resource by its API group, version, and plural
+// resource name, e.g. {Group: "apps", Version: "v1", Resource: "deployments"}.
+type GVR struct {
+	Group    string
+	Version  string
+	Resource string
+}
+
+// resolveGVR walks t down to the *types.Named it ultimately refers to
+// (following pointers and slices, since client.Client arguments are typically
+// *T or *TList) and maps its defining package path and name to a GVR. It
+// reports false if t isn't a named type or its package isn't a recognized
+// Kubernetes API layout.
+func resolveGVR(t types.Type) (GVR, bool) {
+	return resolveGVRKind(t, false)
+}
+
+// resolveGVRForList resolves t (the list argument to List or Watch, e.g.
+// *appsv1.DeploymentList) to the GVR of the items it lists, by stripping the
+// "List" suffix from the Kind before mapping it.
+func resolveGVRForList(t types.Type) (GVR, bool) {
+	return resolveGVRKind(t, true)
+}
+
+func resolveGVRKind(t types.Type, isList bool) (GVR, bool) {
+	named, ok := namedType(t)
+	if !ok {
+		return GVR{}, false
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() == nil {
+		return GVR{}, false
+	}
+
+	group, version, ok := resolveAPIGroupVersion(obj.Pkg().Path())
+	if !ok {
+		return GVR{}, false
+	}
+
+	kind := obj.Name()
+	if isList {
+		kind = strings.TrimSuffix(kind, "List")
+	}
+
+	return GVR{Group: group, Version: version, Resource: pluralizeKind(kind)}, true
+}
+
+// namedType strips pointers and slices to reach the underlying *types.Named,
+// e.g. *appsv1.Deployment -> appsv1.Deployment, []appsv1.Pod -> appsv1.Pod.
+func namedType(t types.Type) (*types.Named, bool) {
+	for {
+		switch u := t.(type) {
+		case *types.Pointer:
+			t = u.Elem()
+		case *types.Slice:
+			t = u.Elem()
+		case *types.Named:
+			return u, true
+		default:
+			return nil, false
+		}
+	}
+}
+
+// resolveAPIGroupVersion maps the import path of a Kubernetes API type to the
+// {group, version} pair RBAC rules are scoped by. It understands the
+// k8s.io/api/<group>/<version> staging layout, a special-cased apimachinery
+// package, and the <module>/apis/<group>/<version> convention used by
+// kubebuilder/operator-sdk CRDs.
+func resolveAPIGroupVersion(pkgPath string) (group, version string, ok bool) {
+	switch {
+	case pkgPath == "k8s.io/apimachinery/pkg/apis/meta/v1":
+		return "meta.k8s.io", "v1", true
+	case strings.HasPrefix(pkgPath, "k8s.io/api/"):
+		return splitGroupVersion(strings.TrimPrefix(pkgPath, "k8s.io/api/"), "core")
+	default:
+		idx := strings.Index(pkgPath, "/apis/")
+		if idx == -1 {
+			return "", "", false
+		}
+		return splitGroupVersion(pkgPath[idx+len("/apis/"):], "")
+	}
+}
+
+// splitGroupVersion splits a "<group>/<version>" path tail into its parts,
+// mapping coreGroupName (if any) to the empty string, the RBAC core API group.
+func splitGroupVersion(rest, coreGroupName string) (group, version string, ok bool) {
+	parts := strings.Split(rest, "/")
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	group = parts[0]
+	if coreGroupName != "" && group == coreGroupName {
+		group = ""
+	}
+	return group, parts[1], true
+}
+
+// getKubernetesResourceName converts CamelCase to Kubernetes-style names (e.g., StorageCluster to storagecluster)
+func getKubernetesResourceName(s string) string {
+	// Regular expression to find camel case boundaries
+	var camelCasePattern = regexp.MustCompile("([a-z0-9])([A-Z])")
+	// Convert camel case to lowercase and concatenate words
+	resourceName := camelCasePattern.ReplaceAllString(s, "${1}${2}")
+	return strings.ToLower(resourceName)
+}
+
+// pluralizeKind converts a Kubernetes Kind to the lowercase plural resource
+// name RBAC rules use, e.g. "Deployment" -> "deployments",
+// "NetworkPolicy" -> "networkpolicies", "Endpoints" -> "endpoints".
+func pluralizeKind(kind string) string {
+	lower := getKubernetesResourceName(kind)
+
+	switch {
+	case lower == "endpoints":
+		return lower
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !isVowel(lower[len(lower)-2]):
+		return lower[:len(lower)-1] + "ies"
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"),
+		strings.HasSuffix(lower, "z"), strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return lower + "es"
+	default:
+		return lower + "s"
+	}
+}
+
+func isVowel(b byte) bool {
+	switch b {
+	case 'a', 'e', 'i', 'o', 'u':
+		return true
+	default:
+		return false
+	}
+}
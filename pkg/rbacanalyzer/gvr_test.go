@@ -0,0 +1,43 @@
+package rbacanalyzer
+
+import "testing"
+
+func TestResolveAPIGroupVersion(t *testing.T) {
+	cases := []struct {
+		pkgPath     string
+		wantGroup   string
+		wantVersion string
+		wantOK      bool
+	}{
+		{"k8s.io/api/apps/v1", "apps", "v1", true},
+		{"k8s.io/api/core/v1", "", "v1", true},
+		{"k8s.io/apimachinery/pkg/apis/meta/v1", "meta.k8s.io", "v1", true},
+		{"github.com/example/operator/apis/cache/v1alpha1", "cache", "v1alpha1", true},
+		{"k8s.io/apimachinery/pkg/runtime", "", "", false},
+	}
+
+	for _, c := range cases {
+		group, version, ok := resolveAPIGroupVersion(c.pkgPath)
+		if ok != c.wantOK || group != c.wantGroup || version != c.wantVersion {
+			t.Errorf("resolveAPIGroupVersion(%q) = (%q, %q, %v), want (%q, %q, %v)",
+				c.pkgPath, group, version, ok, c.wantGroup, c.wantVersion, c.wantOK)
+		}
+	}
+}
+
+func TestPluralizeKind(t *testing.T) {
+	cases := map[string]string{
+		"Deployment":    "deployments",
+		"Ingress":       "ingresses",
+		"NetworkPolicy": "networkpolicies",
+		"Endpoints":     "endpoints",
+		"StorageClass":  "storageclasses",
+		"Pod":           "pods",
+	}
+
+	for kind, want := range cases {
+		if got := pluralizeKind(kind); got != want {
+			t.Errorf("pluralizeKind(%q) = %q, want %q", kind, got, want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package rbacanalyzer
+
+import (
+	"flag"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// markerPattern matches a +kubebuilder:rbac marker comment, e.g.
+// "+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch".
+// Multiple groups/resources/verbs are separated with ";" within a field.
+var markerPattern = regexp.MustCompile(`^\+kubebuilder:rbac:groups=(?P<groups>[^,\s]*),resources=(?P<resources>[^,\s]*),verbs=(?P<verbs>[^,\s]*)`)
+
+// groupResource is the (apiGroup, resource) pair RBAC rules are actually
+// scoped by; unlike GVR it drops the API version, which PolicyRule and
+// +kubebuilder:rbac markers don't carry.
+type groupResource struct {
+	group    string
+	resource string
+}
+
+var strict bool
+
+// MarkersAnalyzer cross-checks the RBAC permissions Analyzer discovers
+// against the +kubebuilder:rbac marker comments already present in the
+// scanned package. It reports two categories of drift: a missing marker (a
+// call requires a verb that no marker grants) always, and, with -strict, an
+// unused marker (a marker grants a verb that no call site exercises) too --
+// so CI can fail the build on operator-sdk/kubebuilder projects where marker
+// drift would otherwise silently break deployments.
+var MarkersAnalyzer = &analysis.Analyzer{
+	Name:     "rbacmarkers",
+	Doc:      "cross-checks +kubebuilder:rbac markers against the RBAC permissions client.Client calls actually require",
+	Run:      runMarkers,
+	Requires: []*analysis.Analyzer{Analyzer},
+	Flags:    markersFlags(),
+}
+
+func markersFlags() flag.FlagSet {
+	var fs flag.FlagSet
+	fs.BoolVar(&strict, "strict", false, "also report markers that grant a verb no call site exercises")
+	return fs
+}
+
+func runMarkers(pass *analysis.Pass) (interface{}, error) {
+	discovered := collapseToGroupResource(pass.ResultOf[Analyzer].(Result).Merged())
+	granted, markerPos := parseMarkers(pass)
+
+	for gr, verbs := range discovered {
+		for verb := range verbs {
+			if !granted[gr][verb] {
+				pass.Reportf(pass.Files[0].Package, "missing +kubebuilder:rbac marker: resource %q requires verb %q", gr.resource, verb)
+			}
+		}
+	}
+
+	if strict {
+		for gr, verbs := range granted {
+			for verb := range verbs {
+				if !discovered[gr][verb] {
+					pass.Reportf(markerPos[gr][verb], "unused +kubebuilder:rbac marker: resource %q grants verb %q that no call site exercises", gr.resource, verb)
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// collapseToGroupResource merges a GVR-keyed map across API versions, since
+// RBAC PolicyRules (and the markers that describe them) aren't version-scoped.
+func collapseToGroupResource(byGVR map[GVR]VerbSet) map[groupResource]VerbSet {
+	collapsed := make(map[groupResource]VerbSet)
+	for gvr, verbs := range byGVR {
+		gr := groupResource{group: gvr.Group, resource: gvr.Resource}
+		if collapsed[gr] == nil {
+			collapsed[gr] = make(VerbSet)
+		}
+		for verb := range verbs {
+			collapsed[gr][verb] = true
+		}
+	}
+	return collapsed
+}
+
+// parseMarkers scans every comment in the package for +kubebuilder:rbac
+// markers, returning the permissions they grant plus the position of the
+// comment that granted each (group, resource, verb) triple, for diagnostics.
+func parseMarkers(pass *analysis.Pass) (map[groupResource]VerbSet, map[groupResource]map[string]token.Pos) {
+	granted := make(map[groupResource]VerbSet)
+	pos := make(map[groupResource]map[string]token.Pos)
+
+	for _, file := range pass.Files {
+		for _, group := range file.Comments {
+			for _, c := range group.List {
+				text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+				match := markerPattern.FindStringSubmatch(text)
+				if match == nil {
+					continue
+				}
+
+				groups := strings.Split(match[1], ";")
+				resources := strings.Split(match[2], ";")
+				verbs := strings.Split(match[3], ";")
+
+				for _, apiGroup := range groups {
+					if apiGroup == "\"\"" {
+						apiGroup = ""
+					}
+					for _, resource := range resources {
+						gr := groupResource{group: apiGroup, resource: resource}
+						if granted[gr] == nil {
+							granted[gr] = make(VerbSet)
+						}
+						if pos[gr] == nil {
+							pos[gr] = make(map[string]token.Pos)
+						}
+						for _, verb := range verbs {
+							granted[gr][verb] = true
+							pos[gr][verb] = c.Pos()
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return granted, pos
+}
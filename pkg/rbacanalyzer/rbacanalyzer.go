@@ -0,0 +1,162 @@
+// Package rbacanalyzer implements a go/analysis Analyzer that reports the
+// Kubernetes RBAC permissions a program requires through its use of
+// sigs.k8s.io/controller-runtime/pkg/client, diagnosing each call site and
+// collecting the result keyed by the Reconciler that owns it and the
+// GroupVersionResource it applies to. RunOnPackages offers the same analysis
+// as a library call instead of a diagnostic driver; RunSSA is a slower but
+// more thorough alternative that follows calls through helper functions and
+// interface-typed parameters via a call graph.
+package rbacanalyzer
+
+import (
+	"go/ast"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const targetPackage = "sigs.k8s.io/controller-runtime/pkg/client"
+
+// methodArgMap maps a client.Client method name to the 1-based index of the
+// argument that carries (or, for List/Watch, lists) the resource's type.
+var methodArgMap = map[string]int{
+	"Get":         3,
+	"List":        2,
+	"Watch":       2,
+	"Update":      2,
+	"Create":      2,
+	"Delete":      2,
+	"Patch":       2,
+	"DeleteAllOf": 2,
+}
+
+// verbMapping translates a client.Client method name into the RBAC verb it requires.
+var verbMapping = map[string]string{
+	"Get":         "get",
+	"List":        "list",
+	"Watch":       "watch",
+	"Create":      "create",
+	"Update":      "update",
+	"Patch":       "patch",
+	"Delete":      "delete",
+	"DeleteAllOf": "deletecollection",
+}
+
+// VerbSet is the set of RBAC verbs ("get", "list", ...) required on a resource.
+type VerbSet map[string]bool
+
+// Controller names the Reconciler a set of RBAC permissions is attributed
+// to. The zero value, "", is used for permissions whose call site isn't
+// attributed to any recognized Reconciler (see controllerName).
+type Controller string
+
+// Result is the value produced by running Analyzer: the RBAC permissions
+// discovered in a package, grouped by the Reconciler that exercises them and,
+// within that, keyed by the resource they apply to. Other analyzers can
+// depend on Analyzer and consume this via pass.ResultOf.
+type Result map[Controller]map[GVR]VerbSet
+
+// Merged flattens Result across all controllers into a single map, for
+// producing one merged ClusterRole alongside the per-controller ones.
+func (r Result) Merged() map[GVR]VerbSet {
+	merged := make(map[GVR]VerbSet)
+	for _, byGVR := range r {
+		for gvr, verbs := range byGVR {
+			if merged[gvr] == nil {
+				merged[gvr] = make(VerbSet)
+			}
+			for verb := range verbs {
+				merged[gvr][verb] = true
+			}
+		}
+	}
+	return merged
+}
+
+// Analyzer reports the RBAC verbs a program requires through its use of
+// controller-runtime's client.Client. Each call site that needs a permission
+// is reported as a diagnostic at its exact position, and the aggregate
+// findings are returned as a Result -- grouped by the Reconciler that owns
+// each call -- for downstream tools (per-controller role generation,
+// marker-drift checking, ...) to consume.
+var Analyzer = &analysis.Analyzer{
+	Name:       "rbac",
+	Doc:        "reports Kubernetes RBAC permissions required by controller-runtime client.Client calls",
+	Run:        run,
+	Requires:   []*analysis.Analyzer{inspect.Analyzer},
+	ResultType: reflect.TypeOf(Result{}),
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	result := make(Result)
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{(*ast.CallExpr)(nil)}
+	ins.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		call := n.(*ast.CallExpr)
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		methodName := sel.Sel.Name
+		argIndex, ok := methodArgMap[methodName]
+		if !ok {
+			return true
+		}
+		processCall(pass, result, call, sel, methodName, argIndex, stack)
+		return true
+	})
+
+	return result, nil
+}
+
+func processCall(pass *analysis.Pass, result Result, call *ast.CallExpr, sel *ast.SelectorExpr, methodName string, argIndex int, stack []ast.Node) {
+	methodObj := pass.TypesInfo.ObjectOf(sel.Sel)
+	if methodObj == nil || methodObj.Pkg() == nil || methodObj.Pkg().Path() != targetPackage {
+		return
+	}
+
+	if len(call.Args) < argIndex {
+		return
+	}
+	arg := call.Args[argIndex-1]
+	argType := pass.TypesInfo.Types[arg].Type
+
+	var gvr GVR
+	var ok bool
+	switch methodName {
+	case "List", "Watch":
+		gvr, ok = resolveGVRForList(argType)
+	default:
+		gvr, ok = resolveGVR(argType)
+	}
+	if !ok {
+		return
+	}
+
+	if suffix, isSubResource := subResourceSuffix(pass, sel); isSubResource {
+		gvr.Resource = gvr.Resource + "/" + suffix
+	}
+
+	verb, ok := verbMapping[methodName]
+	if !ok {
+		return
+	}
+
+	controller, _ := enclosingController(pass, stack)
+
+	if result[controller] == nil {
+		result[controller] = make(map[GVR]VerbSet)
+	}
+	if result[controller][gvr] == nil {
+		result[controller][gvr] = make(VerbSet)
+	}
+	result[controller][gvr][verb] = true
+
+	pass.Reportf(call.Pos(), "controller %q requires RBAC verb %q on resource %q", controller, verb, gvr.Resource)
+}
@@ -0,0 +1,24 @@
+package rbacanalyzer_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/yitzhak12/rbac-analyzer/pkg/rbacanalyzer"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), rbacanalyzer.Analyzer, "a")
+}
+
+func TestAnalyzerAttributesControllers(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), rbacanalyzer.Analyzer, "c")
+}
+
+func TestMarkersAnalyzer(t *testing.T) {
+	if err := rbacanalyzer.MarkersAnalyzer.Flags.Set("strict", "true"); err != nil {
+		t.Fatal(err)
+	}
+	analysistest.Run(t, analysistest.TestData(), rbacanalyzer.MarkersAnalyzer, "b")
+}
@@ -0,0 +1,112 @@
+package rbacanalyzer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// policyRule is a single (apiGroup, resource, verbs) pairing ready to be
+// rendered as a Kubernetes PolicyRule or a +kubebuilder:rbac marker.
+type policyRule struct {
+	apiGroup string
+	resource string
+	verbs    []string
+}
+
+// buildPolicyRules collapses byGVR into one policyRule per (apiGroup,
+// resource) pair, with verbs sorted.
+func buildPolicyRules(byGVR map[GVR]VerbSet) []policyRule {
+	byGroupResource := collapseToGroupResource(byGVR)
+	rules := make([]policyRule, 0, len(byGroupResource))
+	for gr, verbSet := range byGroupResource {
+		verbs := make([]string, 0, len(verbSet))
+		for verb := range verbSet {
+			verbs = append(verbs, verb)
+		}
+		sort.Strings(verbs)
+		rules = append(rules, policyRule{apiGroup: gr.group, resource: gr.resource, verbs: verbs})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].apiGroup != rules[j].apiGroup {
+			return rules[i].apiGroup < rules[j].apiGroup
+		}
+		return rules[i].resource < rules[j].resource
+	})
+	return rules
+}
+
+// RenderClusterRoleYAML renders byGVR as a rbac.authorization.k8s.io/v1
+// ClusterRole, with one PolicyRule per (apiGroup, resource) pair.
+func RenderClusterRoleYAML(roleName string, byGVR map[GVR]VerbSet) string {
+	var b strings.Builder
+	b.WriteString("apiVersion: rbac.authorization.k8s.io/v1\n")
+	b.WriteString("kind: ClusterRole\n")
+	b.WriteString("metadata:\n")
+	fmt.Fprintf(&b, "  name: %s\n", roleName)
+	b.WriteString("rules:\n")
+	for _, rule := range buildPolicyRules(byGVR) {
+		b.WriteString("- apiGroups:\n")
+		fmt.Fprintf(&b, "  - %q\n", rule.apiGroup)
+		b.WriteString("  resources:\n")
+		fmt.Fprintf(&b, "  - %s\n", rule.resource)
+		b.WriteString("  verbs:\n")
+		for _, verb := range rule.verbs {
+			fmt.Fprintf(&b, "  - %s\n", verb)
+		}
+	}
+	return b.String()
+}
+
+// RenderMarkers renders byGVR as +kubebuilder:rbac marker comments, one per
+// (apiGroup, resource) pair, suitable for pasting above a Reconcile method.
+func RenderMarkers(byGVR map[GVR]VerbSet) string {
+	var b strings.Builder
+	for _, rule := range buildPolicyRules(byGVR) {
+		apiGroup := rule.apiGroup
+		if apiGroup == "" {
+			apiGroup = `""`
+		}
+		fmt.Fprintf(&b, "//+kubebuilder:rbac:groups=%s,resources=%s,verbs=%s\n", apiGroup, rule.resource, strings.Join(rule.verbs, ";"))
+	}
+	return b.String()
+}
+
+// RenderPerController renders one ClusterRole (or, with asMarkers, a marker
+// block) per controller in result, named "<prefix>-<controller>" with the
+// controller name lowercased (FooReconciler -> "<prefix>-fooreconciler"), plus a
+// final "<prefix>-merged" role aggregating every controller's permissions --
+// mirroring how operator repos lay out config/rbac/role.yaml per controller.
+// Permissions not attributed to any controller are folded into the merged
+// role only.
+func RenderPerController(prefix string, result Result, asMarkers bool) string {
+	controllers := make([]string, 0, len(result))
+	for controller := range result {
+		if controller != "" {
+			controllers = append(controllers, string(controller))
+		}
+	}
+	sort.Strings(controllers)
+
+	var b strings.Builder
+	for _, controller := range controllers {
+		roleName := prefix + "-" + getKubernetesResourceName(controller)
+		if asMarkers {
+			fmt.Fprintf(&b, "// %s\n", roleName)
+			b.WriteString(RenderMarkers(result[Controller(controller)]))
+		} else {
+			b.WriteString(RenderClusterRoleYAML(roleName, result[Controller(controller)]))
+		}
+		b.WriteString("\n")
+	}
+
+	mergedName := prefix + "-merged"
+	if asMarkers {
+		fmt.Fprintf(&b, "// %s\n", mergedName)
+		b.WriteString(RenderMarkers(result.Merged()))
+	} else {
+		b.WriteString(RenderClusterRoleYAML(mergedName, result.Merged()))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,36 @@
+package rbacanalyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderPerController(t *testing.T) {
+	deployments := GVR{Group: "apps", Version: "v1", Resource: "deployments"}
+	result := Result{
+		"FooReconciler": {
+			deployments: VerbSet{"get": true, "list": true},
+		},
+	}
+
+	out := RenderPerController("test", result, false)
+
+	if !strings.Contains(out, "name: test-fooreconciler") {
+		t.Errorf("expected a per-controller role named test-fooreconciler, got:\n%s", out)
+	}
+	if !strings.Contains(out, "name: test-merged") {
+		t.Errorf("expected a merged role named test-merged, got:\n%s", out)
+	}
+}
+
+func TestRenderMarkersQuotesCoreGroup(t *testing.T) {
+	pods := GVR{Group: "", Version: "v1", Resource: "pods"}
+	byGVR := map[GVR]VerbSet{pods: {"get": true}}
+
+	out := RenderMarkers(byGVR)
+
+	want := `//+kubebuilder:rbac:groups="",resources=pods,verbs=get` + "\n"
+	if out != want {
+		t.Errorf("RenderMarkers(%v) = %q, want %q", byGVR, out, want)
+	}
+}
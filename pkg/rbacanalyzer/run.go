@@ -0,0 +1,73 @@
+package rbacanalyzer
+
+import (
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/packages"
+)
+
+// RunOnPackages executes Analyzer directly over already-loaded packages,
+// without going through a go/analysis driver such as singlechecker. It's the
+// entry point for tools that want the discovered Result itself -- e.g. to
+// render per-controller RBAC manifests -- rather than IDE diagnostics.
+func RunOnPackages(pkgs []*packages.Package) (Result, error) {
+	merged := make(Result)
+
+	for _, pkg := range pkgs {
+		raw, err := runPackage(pkg)
+		if err != nil {
+			return nil, err
+		}
+		mergeInto(merged, raw)
+	}
+
+	return merged, nil
+}
+
+func runPackage(pkg *packages.Package) (Result, error) {
+	insPass := &analysis.Pass{
+		Analyzer:  inspect.Analyzer,
+		Fset:      pkg.Fset,
+		Files:     pkg.Syntax,
+		Pkg:       pkg.Types,
+		TypesInfo: pkg.TypesInfo,
+		ResultOf:  map[*analysis.Analyzer]interface{}{},
+		Report:    func(analysis.Diagnostic) {},
+	}
+	insResult, err := inspect.Analyzer.Run(insPass)
+	if err != nil {
+		return nil, err
+	}
+
+	pass := &analysis.Pass{
+		Analyzer:  Analyzer,
+		Fset:      pkg.Fset,
+		Files:     pkg.Syntax,
+		Pkg:       pkg.Types,
+		TypesInfo: pkg.TypesInfo,
+		ResultOf:  map[*analysis.Analyzer]interface{}{inspect.Analyzer: insResult},
+		Report:    func(analysis.Diagnostic) {},
+	}
+
+	raw, err := Analyzer.Run(pass)
+	if err != nil {
+		return nil, err
+	}
+	return raw.(Result), nil
+}
+
+func mergeInto(dst, src Result) {
+	for controller, byGVR := range src {
+		if dst[controller] == nil {
+			dst[controller] = make(map[GVR]VerbSet)
+		}
+		for gvr, verbs := range byGVR {
+			if dst[controller][gvr] == nil {
+				dst[controller][gvr] = make(VerbSet)
+			}
+			for verb := range verbs {
+				dst[controller][gvr][verb] = true
+			}
+		}
+	}
+}
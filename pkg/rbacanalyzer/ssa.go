@@ -0,0 +1,296 @@
+package rbacanalyzer
+
+import (
+	"go/constant"
+	"go/types"
+
+	"golang.org/x/tools/go/callgraph"
+	"golang.org/x/tools/go/callgraph/cha"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
+)
+
+// RunSSA is the -mode=ssa counterpart to RunOnPackages. Where the AST-only
+// path only sees direct c.Get(...) call sites, RunSSA builds an SSA program
+// and a call graph (via CHA) over pkgs, so that permissions exercised
+// through a helper method -- func (r *R) fetch(...) { r.Client.Get(...) },
+// called from many places -- are propagated to every transitive caller and
+// attributed to the Reconciler that ultimately triggers them. It also
+// resolves the concrete type flowing into an interface-typed argument (e.g.
+// a client.Object parameter whose only caller passes &appsv1.Deployment{}),
+// which the AST path drops. It's considerably slower than RunOnPackages,
+// which is why callers choose between the two with a -mode flag rather than
+// always paying this cost.
+func RunSSA(pkgs []*packages.Package) (Result, error) {
+	prog, _ := ssautil.AllPackages(pkgs, ssa.InstantiateGenerics)
+	prog.Build()
+
+	cg := cha.CallGraph(prog)
+	iface := reconcilerInterface(findSSAPackage(prog.AllPackages(), reconcilePackage))
+
+	result := make(Result)
+	for fn := range ssautil.AllFunctions(prog) {
+		for _, block := range fn.Blocks {
+			for _, instr := range block.Instrs {
+				if call, ok := instr.(*ssa.Call); ok {
+					recordSSACall(result, cg, iface, fn, call)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// recordSSACall attributes the RBAC permission required by call, if any, to
+// every Reconciler that transitively calls fn (or, if none does, to the
+// global "" bucket).
+func recordSSACall(result Result, cg *callgraph.Graph, iface *types.Interface, fn *ssa.Function, call *ssa.Call) {
+	common := call.Common()
+	name, pkgPath, ok := calleeInfo(common)
+	if !ok || pkgPath != targetPackage {
+		return
+	}
+	argIndex, ok := methodArgMap[name]
+	if !ok {
+		return
+	}
+	verb, ok := verbMapping[name]
+	if !ok {
+		return
+	}
+
+	args := callArgsExcludingReceiver(common)
+	if len(args) < argIndex {
+		return
+	}
+
+	argType := args[argIndex-1].Type()
+	if concrete := concreteObjectType(args[argIndex-1], cg, make(map[ssa.Value]bool)); concrete != nil {
+		argType = concrete
+	}
+
+	var gvr GVR
+	switch name {
+	case "List", "Watch":
+		gvr, ok = resolveGVRForList(argType)
+	default:
+		gvr, ok = resolveGVR(argType)
+	}
+	if !ok {
+		return
+	}
+
+	if suffix, isSubResource := ssaSubResourceSuffix(common); isSubResource {
+		gvr.Resource = gvr.Resource + "/" + suffix
+	}
+
+	controllers := callgraphControllers(cg, fn, iface)
+	if len(controllers) == 0 {
+		addResult(result, "", gvr, verb)
+		return
+	}
+	for controller := range controllers {
+		addResult(result, controller, gvr, verb)
+	}
+}
+
+func addResult(result Result, controller Controller, gvr GVR, verb string) {
+	if result[controller] == nil {
+		result[controller] = make(map[GVR]VerbSet)
+	}
+	if result[controller][gvr] == nil {
+		result[controller][gvr] = make(VerbSet)
+	}
+	result[controller][gvr][verb] = true
+}
+
+// calleeInfo returns the method name and declaring package path of the
+// callee of common, whether it's reached through interface dispatch (the
+// common case for client.Client, since it's an interface) or a direct call
+// to a concrete method.
+func calleeInfo(common *ssa.CallCommon) (name, pkgPath string, ok bool) {
+	if common.IsInvoke() {
+		m := common.Method
+		if m.Pkg() == nil {
+			return "", "", false
+		}
+		return m.Name(), m.Pkg().Path(), true
+	}
+
+	fn, isFn := common.Value.(*ssa.Function)
+	if !isFn || fn.Object() == nil || fn.Object().Pkg() == nil {
+		return "", "", false
+	}
+	return fn.Name(), fn.Object().Pkg().Path(), true
+}
+
+// callArgsExcludingReceiver returns common's arguments with any leading
+// receiver value stripped, so that argument N lines up with the same 1-based
+// index methodArgMap uses for the AST path regardless of whether common
+// dispatches through an interface (no receiver in Args) or a concrete method
+// (receiver prepended to Args).
+func callArgsExcludingReceiver(common *ssa.CallCommon) []ssa.Value {
+	if common.IsInvoke() {
+		return common.Args
+	}
+	if fn, ok := common.Value.(*ssa.Function); ok && fn.Signature.Recv() != nil {
+		if len(common.Args) == 0 {
+			return nil
+		}
+		return common.Args[1:]
+	}
+	return common.Args
+}
+
+// concreteObjectType resolves the concrete type flowing into v, even when
+// v's static type is an interface such as client.Object: it unwraps the
+// *ssa.MakeInterface that wraps a concrete value (e.g. &appsv1.Deployment{})
+// and, if v is itself a parameter, follows the call graph to the concrete
+// argument its callers pass. It returns nil if no concrete type can be
+// determined. seen guards against infinite recursion through call cycles.
+func concreteObjectType(v ssa.Value, cg *callgraph.Graph, seen map[ssa.Value]bool) types.Type {
+	if seen[v] {
+		return nil
+	}
+	seen[v] = true
+
+	switch val := v.(type) {
+	case *ssa.MakeInterface:
+		return val.X.Type()
+	case *ssa.ChangeType:
+		return concreteObjectType(val.X, cg, seen)
+	case *ssa.Convert:
+		return concreteObjectType(val.X, cg, seen)
+	case *ssa.Parameter:
+		index := paramIndex(val)
+		node := cg.Nodes[val.Parent()]
+		if index < 0 || node == nil {
+			return nil
+		}
+		for _, edge := range node.In {
+			callerArgs := callArgsExcludingReceiver(edge.Site.Common())
+			if index >= len(callerArgs) {
+				continue
+			}
+			if t := concreteObjectType(callerArgs[index], cg, seen); t != nil {
+				return t
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// paramIndex returns param's index into the receiver-stripped argument list
+// that callArgsExcludingReceiver produces for param.Parent()'s callers. Since
+// param.Parent().Params includes the receiver as element 0 for methods, that
+// offset is subtracted back out here so the result lines up with
+// callArgsExcludingReceiver regardless of whether param's function is a
+// method.
+func paramIndex(param *ssa.Parameter) int {
+	offset := 0
+	if param.Parent().Signature.Recv() != nil {
+		offset = 1
+	}
+	for i, p := range param.Parent().Params {
+		if p == param {
+			return i - offset
+		}
+	}
+	return -1
+}
+
+// ssaSubResourceSuffix is the SSA equivalent of subResourceSuffix: it
+// detects a call reached through a chained sub-resource client, e.g.
+// c.Status().Update(...), where common.Value is itself the *ssa.Call to
+// Status() or SubResource(name).
+func ssaSubResourceSuffix(common *ssa.CallCommon) (suffix string, ok bool) {
+	if !common.IsInvoke() {
+		return "", false
+	}
+	innerCall, isCall := common.Value.(*ssa.Call)
+	if !isCall {
+		return "", false
+	}
+	innerCommon := innerCall.Common()
+
+	name, pkgPath, ok := calleeInfo(innerCommon)
+	if !ok || pkgPath != targetPackage {
+		return "", false
+	}
+
+	switch name {
+	case "Status":
+		return "status", true
+	case "SubResource":
+		args := callArgsExcludingReceiver(innerCommon)
+		if len(args) != 1 {
+			return "", false
+		}
+		lit, isConst := args[0].(*ssa.Const)
+		if !isConst || lit.Value == nil || lit.Value.Kind() != constant.String {
+			return "", false
+		}
+		return constant.StringVal(lit.Value), true
+	default:
+		return "", false
+	}
+}
+
+// callgraphControllers returns every Reconciler that transitively calls fn,
+// by walking cg's caller edges up from fn. A function attributes to itself
+// too, so a call made directly inside a Reconcile method is covered without
+// needing a caller at all.
+func callgraphControllers(cg *callgraph.Graph, fn *ssa.Function, iface *types.Interface) map[Controller]bool {
+	controllers := make(map[Controller]bool)
+	visited := make(map[*ssa.Function]bool)
+
+	var walk func(fn *ssa.Function)
+	walk = func(fn *ssa.Function) {
+		if visited[fn] {
+			return
+		}
+		visited[fn] = true
+
+		if controller, ok := controllerFor(fn, iface); ok {
+			controllers[controller] = true
+		}
+
+		node := cg.Nodes[fn]
+		if node == nil {
+			return
+		}
+		for _, edge := range node.In {
+			walk(edge.Caller.Func)
+		}
+	}
+	walk(fn)
+
+	return controllers
+}
+
+func controllerFor(fn *ssa.Function, iface *types.Interface) (Controller, bool) {
+	sig := fn.Signature
+	if sig.Recv() == nil {
+		return "", false
+	}
+	named, ok := namedType(sig.Recv().Type())
+	if !ok {
+		return "", false
+	}
+	return controllerName(named, fn.Name(), iface)
+}
+
+// findSSAPackage searches prog's built packages for path, returning its
+// *types.Package, or nil if the program doesn't depend on it.
+func findSSAPackage(pkgs []*ssa.Package, path string) *types.Package {
+	for _, pkg := range pkgs {
+		if pkg != nil && pkg.Pkg.Path() == path {
+			return pkg.Pkg
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package rbacanalyzer
+
+import (
+	"os"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// loadSSATestdata loads pkgName from testdata/src in GOPATH mode, with the
+// NeedDeps/NeedName modes RunSSA requires to build a call graph, mirroring
+// how cmd/rbacroles loads -mode=ssa input.
+func loadSSATestdata(t *testing.T, pkgName string) []*packages.Package {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypesInfo |
+			packages.NeedTypes | packages.NeedImports | packages.NeedDeps,
+		Env: append(os.Environ(), "GOPATH="+wd+"/testdata", "GO111MODULE=off"),
+	}
+
+	pkgs, err := packages.Load(cfg, pkgName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		t.Fatalf("errors loading package %q", pkgName)
+	}
+	return pkgs
+}
+
+// TestRunSSAFollowsHelperMethod is the motivating example for RunSSA: the
+// Get call is buried inside fetch, a helper method on the same Reconciler,
+// rather than inlined in Reconcile. RunSSA must still attribute the
+// resulting "get" permission on "deployments" to FooReconciler by walking
+// the call graph from fetch back up to Reconcile.
+func TestRunSSAFollowsHelperMethod(t *testing.T) {
+	pkgs := loadSSATestdata(t, "d")
+
+	result, err := RunSSA(pkgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deployments := GVR{Group: "apps", Version: "v1", Resource: "deployments"}
+	if !result["FooReconciler"][deployments]["get"] {
+		t.Errorf("expected FooReconciler to require \"get\" on deployments, got %+v", result)
+	}
+}
@@ -0,0 +1,49 @@
+package rbacanalyzer
+
+import (
+	"go/ast"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// subResourceSuffix detects whether a call is being made through a chained
+// sub-resource client, e.g. c.Status().Update(...) or
+// c.SubResource("scale").Patch(...), and if so returns the RBAC subresource
+// suffix ("status", "scale", ...) that should be appended to the resource
+// name so the emitted RBAC includes e.g. deployments/status.
+func subResourceSuffix(pass *analysis.Pass, sel *ast.SelectorExpr) (suffix string, ok bool) {
+	innerCall, isCall := sel.X.(*ast.CallExpr)
+	if !isCall {
+		return "", false
+	}
+	innerSel, isSel := innerCall.Fun.(*ast.SelectorExpr)
+	if !isSel {
+		return "", false
+	}
+
+	innerObj := pass.TypesInfo.ObjectOf(innerSel.Sel)
+	if innerObj == nil || innerObj.Pkg() == nil || innerObj.Pkg().Path() != targetPackage {
+		return "", false
+	}
+
+	switch innerSel.Sel.Name {
+	case "Status":
+		return "status", true
+	case "SubResource":
+		if len(innerCall.Args) != 1 {
+			return "", false
+		}
+		lit, isLit := innerCall.Args[0].(*ast.BasicLit)
+		if !isLit {
+			return "", false
+		}
+		name, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return "", false
+		}
+		return name, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,19 @@
+package a
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func run(c client.Client) {
+	var dep appsv1.Deployment
+	var list appsv1.DeploymentList
+
+	c.Get(nil, client.ObjectKey{}, &dep)     // want `controller "" requires RBAC verb "get" on resource "deployments"`
+	c.List(nil, &list)                       // want `controller "" requires RBAC verb "list" on resource "deployments"`
+	c.Create(nil, &dep)                      // want `controller "" requires RBAC verb "create" on resource "deployments"`
+	c.Status().Update(nil, &dep)             // want `controller "" requires RBAC verb "update" on resource "deployments/status"`
+	c.Watch(nil, &list)                      // want `controller "" requires RBAC verb "watch" on resource "deployments"`
+	c.DeleteAllOf(nil, &dep)                 // want `controller "" requires RBAC verb "deletecollection" on resource "deployments"`
+	c.SubResource("scale").Update(nil, &dep) // want `controller "" requires RBAC verb "update" on resource "deployments/scale"`
+}
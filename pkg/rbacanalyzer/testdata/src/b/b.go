@@ -0,0 +1,14 @@
+package b // want `missing \+kubebuilder:rbac marker: resource "deployments" requires verb "create"`
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list // want `unused \+kubebuilder:rbac marker: resource "deployments" grants verb "list" that no call site exercises`
+//+kubebuilder:rbac:groups=apps,resources=deployments,verbs=watch // want `unused \+kubebuilder:rbac marker: resource "deployments" grants verb "watch" that no call site exercises`
+func run(c client.Client) {
+	var dep appsv1.Deployment
+	c.Get(nil, client.ObjectKey{}, &dep)
+	c.Create(nil, &dep)
+}
@@ -0,0 +1,20 @@
+package c
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type FooReconciler struct {
+	Client client.Client
+}
+
+func (r *FooReconciler) Reconcile() {
+	var dep appsv1.Deployment
+	r.Client.Get(nil, client.ObjectKey{}, &dep) // want `controller "FooReconciler" requires RBAC verb "get" on resource "deployments"`
+}
+
+func standalone(c client.Client) {
+	var dep appsv1.Deployment
+	c.Create(nil, &dep) // want `controller "" requires RBAC verb "create" on resource "deployments"`
+}
@@ -0,0 +1,24 @@
+// Package d exercises the call-graph-through-a-helper-method case RunSSA is
+// for: the permission-bearing call is buried inside fetch, a method on the
+// same Reconciler, rather than inlined in Reconcile itself.
+package d
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type FooReconciler struct {
+	Client client.Client
+}
+
+func (r *FooReconciler) Reconcile() {
+	var dep appsv1.Deployment
+	r.fetch(nil, client.ObjectKey{}, &dep)
+}
+
+func (r *FooReconciler) fetch(ctx context.Context, key client.ObjectKey, obj client.Object) {
+	r.Client.Get(ctx, key, obj)
+}
@@ -0,0 +1,7 @@
+// Package v1 is a minimal stand-in for k8s.io/api/apps/v1, containing just
+// the types the rbacanalyzer testdata fixtures need.
+package v1
+
+type Deployment struct{}
+
+type DeploymentList struct{}
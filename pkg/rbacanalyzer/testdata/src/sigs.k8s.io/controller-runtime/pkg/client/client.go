@@ -0,0 +1,51 @@
+// Package client is a minimal stand-in for
+// sigs.k8s.io/controller-runtime/pkg/client, containing just enough of the
+// Client interface shape for the rbacanalyzer testdata fixtures to type-check.
+package client
+
+import "context"
+
+type ObjectKey struct{ Name, Namespace string }
+
+type Object interface{}
+type ObjectList interface{}
+type Patch interface{}
+
+type GetOption interface{}
+type ListOption interface{}
+type CreateOption interface{}
+type UpdateOption interface{}
+type PatchOption interface{}
+type DeleteOption interface{}
+type DeleteAllOfOption interface{}
+
+type StatusWriter interface {
+	Update(ctx context.Context, obj Object, opts ...UpdateOption) error
+	Patch(ctx context.Context, obj Object, patch Patch, opts ...PatchOption) error
+}
+
+type SubResourceWriter interface {
+	Update(ctx context.Context, obj Object, opts ...UpdateOption) error
+	Patch(ctx context.Context, obj Object, patch Patch, opts ...PatchOption) error
+}
+
+type Reader interface {
+	Get(ctx context.Context, key ObjectKey, obj Object, opts ...GetOption) error
+	List(ctx context.Context, list ObjectList, opts ...ListOption) error
+}
+
+type Writer interface {
+	Create(ctx context.Context, obj Object, opts ...CreateOption) error
+	Update(ctx context.Context, obj Object, opts ...UpdateOption) error
+	Delete(ctx context.Context, obj Object, opts ...DeleteOption) error
+	Patch(ctx context.Context, obj Object, patch Patch, opts ...PatchOption) error
+	DeleteAllOf(ctx context.Context, obj Object, opts ...DeleteAllOfOption) error
+}
+
+type Client interface {
+	Reader
+	Writer
+	Status() StatusWriter
+	SubResource(subResource string) SubResourceWriter
+	Watch(ctx context.Context, list ObjectList, opts ...ListOption) error
+}